@@ -691,7 +691,7 @@ static int insert_displ_data(int *rbuf, int size, int sendtype_size, int recvtyp
 
 // Compare new send count data with existing data.
 // If there is a match, increase the counter. Add new data, otherwise.
-static int insert_sendrecv_count_data(int *sbuf, int *rbuf, int size, int sendtype_size, int recvtype_size)
+static int insert_sendrecv_count_data(int *sbuf, int *rbuf, int size, int sendtype_size, int recvtype_size, int64_t sendtype_extent, int64_t recvtype_extent, int sendtype_contiguous, int recvtype_contiguous, char *sendtype_name, char *recvtype_name)
 {
     int num = 0;
     struct SRCountNode *newNode = NULL;
@@ -706,7 +706,7 @@ static int insert_sendrecv_count_data(int *sbuf, int *rbuf, int size, int sendty
     temp = counts_head;
     while (temp != NULL)
     {
-        if (temp->size != size || temp->recvtype_size != recvtype_size || temp->sendtype_size != sendtype_size || !same_call_counters(temp, sbuf, rbuf, size))
+        if (temp->size != size || temp->recvtype_size != recvtype_size || temp->sendtype_size != sendtype_size || temp->recvtype_extent != recvtype_extent || temp->sendtype_extent != sendtype_extent || temp->recvtype_contiguous != recvtype_contiguous || temp->sendtype_contiguous != sendtype_contiguous || strcmp(temp->recvtype_name, recvtype_name) != 0 || strcmp(temp->sendtype_name, sendtype_name) != 0 || !same_call_counters(temp, sbuf, rbuf, size))
         {
             // New data
 #if DEBUG
@@ -788,6 +788,14 @@ static int insert_sendrecv_count_data(int *sbuf, int *rbuf, int size, int sendty
 
     newNode->sendtype_size = sendtype_size;
     newNode->recvtype_size = recvtype_size;
+    newNode->sendtype_extent = sendtype_extent;
+    newNode->recvtype_extent = recvtype_extent;
+    newNode->sendtype_contiguous = sendtype_contiguous;
+    newNode->recvtype_contiguous = recvtype_contiguous;
+    newNode->sendtype_name = strdup(sendtype_name);
+    assert(newNode->sendtype_name);
+    newNode->recvtype_name = strdup(recvtype_name);
+    assert(newNode->recvtype_name);
     newNode->list_calls[0] = allgathervCalls;
     newNode->next = NULL;
 #if DEBUG
@@ -1064,6 +1072,8 @@ static int _release_counts_resources()
         free(counts_head->recv_data);
         free(counts_head->send_data);
         free(counts_head->list_calls);
+        free(counts_head->sendtype_name);
+        free(counts_head->recvtype_name);
 
         free(counts_head);
         counts_head = c_ptr;
@@ -1510,10 +1520,12 @@ int _mpi_allgatherv(const void *sendbuf, const int sendcount, MPI_Datatype sendt
 
 #if ((ENABLE_RAW_DATA || ENABLE_PER_RANK_STATS || ENABLE_VALIDATION) && ENABLE_COMPACT_FORMAT)
             DEBUG_ALLGATHERV_PROFILING("Saving data of call #%" PRIu64 ".\n", allgathervCalls);
-            int s_dt_size, r_dt_size;
-            PMPI_Type_size(sendtype, &s_dt_size);
-            PMPI_Type_size(recvtype, &r_dt_size);
-            if (insert_sendrecv_count_data(sbuf, rbuf, comm_size, s_dt_size, r_dt_size))
+            datatype_info_t s_dt_info, r_dt_info;
+            s_dt_info.analyzed = false;
+            r_dt_info.analyzed = false;
+            analyze_datatype(sendtype, &s_dt_info);
+            analyze_datatype(recvtype, &r_dt_info);
+            if (insert_sendrecv_count_data(sbuf, rbuf, comm_size, s_dt_info.size, r_dt_info.size, (int64_t)s_dt_info.extent, (int64_t)r_dt_info.extent, s_dt_info.is_contiguous, r_dt_info.is_contiguous, s_dt_info.name, r_dt_info.name))
             {
                 fprintf(stderr, "[%s:%d][ERROR] unable to insert send/recv counts\n", __FILE__, __LINE__);
                 PMPI_Abort(MPI_COMM_WORLD, 1);
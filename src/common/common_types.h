@@ -31,6 +31,12 @@ typedef struct SRCountNode
     int comm;
     int sendtype_size;
     int recvtype_size;
+    int64_t sendtype_extent;   // Differs from the size when the send datatype is a derived datatype with gaps
+    int64_t recvtype_extent;   // Differs from the size when the recv datatype is a derived datatype with gaps
+    int sendtype_contiguous;   // 0 when the send datatype requires packing
+    int recvtype_contiguous;   // 0 when the recv datatype requires unpacking
+    char *sendtype_name;       // Name of the send datatype, empty if the datatype has no name
+    char *recvtype_name;       // Name of the recv datatype, empty if the datatype has no name
     int send_data_size;        // Size of the array of unique series of send counters
     int recv_data_size;        // Size of the array of unique series of recv counters
     counts_data_t **send_data; // Array of unique series of send counters
@@ -6,10 +6,12 @@
 
 #include <stdlib.h>
 #include <stdio.h>
+#include <string.h>
 
 #include "collective_profiler_config.h"
 #include "common_utils.h"
 #include "comm.h"
+#include "format.h"
 
 #ifndef COLLECTIVE_PROFILER_DATATYPE_H
 #define COLLECTIVE_PROFILER_DATATYPE_H
@@ -53,7 +55,10 @@ typedef struct datatype_info
     bool is_contiguous;
     bool is_predefined;
     int size;
+    MPI_Aint lb;
+    MPI_Aint extent;
     type_id_t id;
+    char name[MPI_MAX_OBJECT_NAME];
 
     MPI_Datatype type;
 } datatype_info_t;
@@ -161,6 +166,8 @@ open_datatype_info_file(char *collective_name, uint32_t comm_id, int world_rank,
 
     FILE *f = fopen(filename, "w");
     assert(f);
+    // Write the format version at the begining of the file
+    FORMAT_VERSION_WRITE(f);
 
     *file = f;
     *file_name = filename;
@@ -202,7 +209,7 @@ get_predefined_type(datatype_info_t *info)
     }
     if (info->type == MPI_UNSIGNED)
     {
-        info->id = MPI_UNSIGNED_SHORT_ID;
+        info->id = MPI_UNSIGNED_ID;
         return;
     }
     if (info->type == MPI_LONG)
@@ -323,6 +330,7 @@ analyze_datatype(MPI_Datatype type, datatype_info_t *i)
     int dt_num_addresses;
     int dt_num_datatypes;
     int dt_combiner;
+    int name_len;
 
     assert(i);
     if (i->analyzed)
@@ -333,6 +341,8 @@ analyze_datatype(MPI_Datatype type, datatype_info_t *i)
     i->type = type;
 
     PMPI_Type_size(type, &(i->size));
+    PMPI_Type_get_extent(type, &(i->lb), &(i->extent));
+    PMPI_Type_get_name(type, i->name, &name_len);
     PMPI_Type_get_envelope(type, &dt_num_intergers, &dt_num_addresses, &dt_num_datatypes, &dt_combiner);
 
     if (dt_combiner == MPI_COMBINER_NAMED)
@@ -365,10 +375,18 @@ save_datatype_info(char *collective_name, MPI_Comm comm, int comm_rank, int worl
 
     if (dt_info->is_predefined)
     {
-        char *type_id = type_id_to_str(dt_info->id);
-        fprintf(file, "Predefined type: %s\n", type_id);
+        // The name from the MPI library also covers the pre-defined datatypes that type_id_to_str() does not know
+        char *type_name = strlen(dt_info->name) > 0 ? dt_info->name : type_id_to_str(dt_info->id);
+        fprintf(file, "Predefined type: %s\n", type_name);
+    }
+    else if (strlen(dt_info->name) > 0)
+    {
+        // Name set by the application with MPI_Type_set_name()
+        fprintf(file, "Name: %s\n", dt_info->name);
     }
     fprintf(file, "Size: %d\n", dt_info->size);
+    fprintf(file, "Lower bound: %" PRId64 "\n", (int64_t)dt_info->lb);
+    fprintf(file, "Extent: %" PRId64 "\n", (int64_t)dt_info->extent);
     fprintf(file, "Datatype is contiguous: %d\n", dt_info->is_contiguous);
     fprintf(file, "Datatype is pre-defined: %d\n", dt_info->is_predefined);
 
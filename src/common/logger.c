@@ -29,7 +29,10 @@ extern int log_counts(logger_t *logger,
                       counts_data_t **counters,
                       int size,
                       int rank_vec_len,
-                      int type_size);
+                      int type_size,
+                      int64_t type_extent,
+                      int type_contiguous,
+                      char *type_name);
 #endif // ENABLE_COUNTS
 
 #if ENABLE_DISPLS
@@ -115,6 +118,7 @@ static void log_sums(logger_t *logger, int ctx, int *sums, int size)
 // _log_data is the low-level function to write the data to file.
 // Note that the list can either be a list of counts or displacements based on the
 // context (only one at a time, i.e., it can only be counts or displacements during a given execution)
+// The datatype extent, contiguity and name are only saved with counts, they are ignored for displacements.
 static void _log_data(logger_t *logger,
                       uint64_t startcall,
                       uint64_t endcall,
@@ -125,7 +129,10 @@ static void _log_data(logger_t *logger,
                       void **list,
                       int size,
                       int rank_vec_len,
-                      int type_size)
+                      int type_size,
+                      int64_t type_extent,
+                      int type_contiguous,
+                      char *type_name)
 {
     FILE *fh = NULL;
     counts_data_t **counters = NULL;
@@ -175,7 +182,7 @@ static void _log_data(logger_t *logger,
     assert(logger->f);
 
 #if ENABLE_COUNTS
-    log_counts(logger, startcall, endcall, ctx, count, calls, num_data, counters, size, rank_vec_len, type_size);
+    log_counts(logger, startcall, endcall, ctx, count, calls, num_data, counters, size, rank_vec_len, type_size, type_extent, type_contiguous, type_name);
 #endif // ENABLE_COUNTS
 
 #if ENABLE_DISPLS
@@ -378,14 +385,14 @@ static void log_data(logger_t *logger, uint64_t startcall, uint64_t endcall, SRC
 
             _log_data(logger, startcall, endcall,
                       SEND_CTX, srDisplPtr->count, srDisplPtr->list_calls,
-                      srDisplPtr->send_data_size, srDisplPtr->send_data, srDisplPtr->size, srDisplPtr->rank_send_vec_len, srDisplPtr->sendtype_size);
+                      srDisplPtr->send_data_size, srDisplPtr->send_data, srDisplPtr->size, srDisplPtr->rank_send_vec_len, srDisplPtr->sendtype_size, 0, 0, NULL);
 
             DEBUG_LOGGER("Logging recv displacements (number of displacement series: %d)\n", srDisplPtr->recv_data_size);
             fprintf(logger->f, "### Data received per rank - Type size: %d\n\n", srDisplPtr->recvtype_size);
 
             _log_data(logger, startcall, endcall,
                       RECV_CTX, srDisplPtr->count, srDisplPtr->list_calls,
-                      srDisplPtr->recv_data_size, srDisplPtr->recv_data, srDisplPtr->size, srDisplPtr->rank_recv_vec_len, srDisplPtr->recvtype_size);
+                      srDisplPtr->recv_data_size, srDisplPtr->recv_data, srDisplPtr->size, srDisplPtr->rank_recv_vec_len, srDisplPtr->recvtype_size, 0, 0, NULL);
 
             DEBUG_LOGGER("%s call %" PRIu64 " logged\n", logger->collective_name, srDisplPtr->count);
             srDisplPtr = srDisplPtr->next;
@@ -425,14 +432,14 @@ static void log_data(logger_t *logger, uint64_t startcall, uint64_t endcall, SRC
 
             _log_data(logger, startcall, endcall,
                       SEND_CTX, srCountPtr->count, (void *)srCountPtr->list_calls,
-                      srCountPtr->send_data_size, srCountPtr->send_data, srCountPtr->size, srCountPtr->rank_send_vec_len, srCountPtr->sendtype_size);
+                      srCountPtr->send_data_size, srCountPtr->send_data, srCountPtr->size, srCountPtr->rank_send_vec_len, srCountPtr->sendtype_size, srCountPtr->sendtype_extent, srCountPtr->sendtype_contiguous, srCountPtr->sendtype_name);
 
             DEBUG_LOGGER("Logging recv counts (number of count series: %d)\n", srCountPtr->recv_data_size);
             fprintf(logger->f, "### Data received per rank - Type size: %d\n\n", srCountPtr->recvtype_size);
 
             _log_data(logger, startcall, endcall,
                       RECV_CTX, srCountPtr->count, (void *)srCountPtr->list_calls,
-                      srCountPtr->recv_data_size, srCountPtr->recv_data, srCountPtr->size, srCountPtr->rank_recv_vec_len, srCountPtr->recvtype_size);
+                      srCountPtr->recv_data_size, srCountPtr->recv_data, srCountPtr->size, srCountPtr->rank_recv_vec_len, srCountPtr->recvtype_size, srCountPtr->recvtype_extent, srCountPtr->recvtype_contiguous, srCountPtr->recvtype_name);
 
             DEBUG_LOGGER("%s call %" PRIu64 " logged\n", logger->collective_name, srCountPtr->count);
             srCountPtr = srCountPtr->next;
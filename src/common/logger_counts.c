@@ -53,12 +53,16 @@ int log_counts(logger_t *logger,
                counts_data_t **counters,
                int size,
                int rank_vec_len,
-               int type_size)
+               int type_size,
+               int64_t type_extent,
+               int type_contiguous,
+               char *type_name)
 {
     FILE *fh = NULL;
     assert(logger);
     assert(calls);
     assert(counters);
+    assert(type_name);
     switch (ctx)
     {
     case RECV_CTX:
@@ -88,6 +92,9 @@ int log_counts(logger_t *logger,
     fprintf(fh, "# Raw counters\n\n");
     fprintf(fh, "Number of ranks: %d\n", size);
     fprintf(fh, "Datatype size: %d\n", type_size);
+    fprintf(fh, "Datatype extent: %" PRId64 "\n", type_extent);
+    fprintf(fh, "Datatype is contiguous: %d\n", type_contiguous);
+    fprintf(fh, "Datatype name: %s\n", type_name);
     fprintf(fh, "%s calls %" PRIu64 "-%" PRIu64 "\n", logger->collective_name, startcall, endcall - 1); // endcall is one ahead so we substract 1
     char *calls_str = compress_uint64_array(calls, count, 1);
     fprintf(fh, "Count: %" PRIu64 " calls - %s\n", count, calls_str);
@@ -4,4 +4,7 @@
 #
 
 # Avoid duplicating the list of common objects is makefiles.
-COMMON_OBJECTS=../common/format.o ../common/comm.o ../common/backtrace.o ../common/grouping.o ../common/location.o
\ No newline at end of file
+COMMON_OBJECTS=../common/format.o ../common/comm.o ../common/backtrace.o ../common/grouping.o ../common/location.o
+
+# Version of the data format, written at the beginning of the generated files.
+FORMATVERSION := `cat ../../FORMAT_VERSION`